@@ -0,0 +1,60 @@
+package shared
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// LatestRunID resolves a `--latest` selector to a concrete run ID by asking
+// the API for the single most recent run, optionally narrowed down by
+// workflow, branch, event, actor, or status. This mirrors the
+// `/actions/runs/latest` convenience endpoint some forges expose, which gh
+// doesn't have, so we emulate it with `per_page=1`. Shared by `run watch`
+// and `run view` so the two commands' `--latest` behavior can't drift.
+func LatestRunID(client *api.Client, repo ghrepo.Interface, workflow, branch, event, user, status string) (string, error) {
+	q := url.Values{}
+	q.Set("per_page", "1")
+	if branch != "" {
+		q.Set("branch", branch)
+	}
+	if event != "" {
+		q.Set("event", event)
+	}
+	if user != "" {
+		q.Set("actor", user)
+	}
+	if status != "" {
+		q.Set("status", status)
+	}
+
+	path := fmt.Sprintf("repos/%s/actions/runs", ghrepo.FullName(repo))
+	if workflow != "" {
+		path = fmt.Sprintf("repos/%s/actions/workflows/%s/runs", ghrepo.FullName(repo), url.PathEscape(workflow))
+	}
+	path = fmt.Sprintf("%s?%s", path, q.Encode())
+
+	var result RunsPayload
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &result); err != nil {
+		return "", err
+	}
+
+	if len(result.WorkflowRuns) == 0 {
+		filters := []string{}
+		if workflow != "" {
+			filters = append(filters, fmt.Sprintf("workflow %q", workflow))
+		}
+		if branch != "" {
+			filters = append(filters, fmt.Sprintf("branch %q", branch))
+		}
+		if len(filters) > 0 {
+			return "", fmt.Errorf("no runs found for %s", strings.Join(filters, ", "))
+		}
+		return "", fmt.Errorf("no runs found")
+	}
+
+	return fmt.Sprintf("%d", result.WorkflowRuns[0].ID), nil
+}
@@ -0,0 +1,171 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/run/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/utils"
+	"github.com/spf13/cobra"
+)
+
+type ViewOptions struct {
+	HttpClient    func() (*http.Client, error)
+	IO            *iostreams.IOStreams
+	BaseRepo      func() (ghrepo.Interface, error)
+	OpenInBrowser func(string) error
+
+	RunID string
+	Web   bool
+
+	Prompt bool
+	Latest bool
+
+	WorkflowSelector string
+	Branch           string
+	Event            string
+	User             string
+	Status           string
+}
+
+func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
+	opts := &ViewOptions{
+		IO:            f.IOStreams,
+		HttpClient:    f.HttpClient,
+		OpenInBrowser: utils.OpenInBrowser,
+	}
+
+	cmd := &cobra.Command{
+		Use:    "view [<run-id>]",
+		Short:  "View a summary of a workflow run",
+		Hidden: true,
+		Example: heredoc.Doc(`
+			# Interactively select a run to view
+			$ gh run view
+
+			# View a specific run
+			$ gh run view 0451
+
+			# View the most recent run
+			$ gh run view --latest
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				if args[0] == "latest" {
+					opts.Latest = true
+				} else {
+					opts.RunID = args[0]
+				}
+			} else if opts.Latest {
+				// no-op, resolved below
+			} else if !opts.IO.CanPrompt() {
+				return &cmdutil.FlagError{Err: errors.New("run ID required when not running interactively")}
+			} else {
+				opts.Prompt = true
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return runView(opts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open run in the browser")
+	cmd.Flags().BoolVar(&opts.Latest, "latest", false, "View the most recent run")
+	cmd.Flags().StringVar(&opts.WorkflowSelector, "workflow", "", "Filter the latest run by workflow")
+	cmd.Flags().StringVar(&opts.Branch, "branch", "", "Filter the latest run by branch")
+	cmd.Flags().StringVar(&opts.Event, "event", "", "Filter the latest run by event type")
+	cmd.Flags().StringVar(&opts.User, "user", "", "Filter the latest run by the user who triggered it")
+	cmd.Flags().StringVar(&opts.Status, "status", "", "Filter the latest run by status or conclusion")
+
+	return cmd
+}
+
+func runView(opts *ViewOptions) error {
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	runID := opts.RunID
+
+	if opts.Latest {
+		runID, err = shared.LatestRunID(client, repo, opts.WorkflowSelector, opts.Branch, opts.Event, opts.User, opts.Status)
+		if err != nil {
+			return fmt.Errorf("failed to find the latest run: %w", err)
+		}
+	} else if opts.Prompt {
+		cs := opts.IO.ColorScheme()
+		runID, err = shared.PromptForRun(cs, client, repo)
+		if err != nil {
+			return err
+		}
+	}
+
+	run, err := shared.GetRun(client, repo, runID)
+	if err != nil {
+		return fmt.Errorf("failed to get run: %w", err)
+	}
+
+	if opts.Web {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "Opening %s in your browser.\n", utils.DisplayURL(run.URL))
+		}
+		return opts.OpenInBrowser(run.URL)
+	}
+
+	prNumber := ""
+	if number, err := shared.PullRequestForRun(client, repo, *run); err == nil {
+		prNumber = fmt.Sprintf(" #%d", number)
+	}
+
+	jobs, err := shared.GetJobs(client, repo, *run)
+	if err != nil {
+		return fmt.Errorf("failed to get jobs: %w", err)
+	}
+
+	var annotations []shared.Annotation
+	for _, job := range jobs {
+		as, err := shared.GetAnnotations(client, repo, job)
+		if err != nil {
+			return fmt.Errorf("failed to get annotations: %w", err)
+		}
+		annotations = append(annotations, as...)
+	}
+
+	out := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	fmt.Fprintln(out, shared.RenderRunHeader(cs, *run, "", prNumber))
+	fmt.Fprintln(out)
+
+	if len(jobs) > 0 {
+		fmt.Fprintln(out, cs.Bold("JOBS"))
+		fmt.Fprintln(out, shared.RenderJobs(cs, jobs, true))
+	}
+
+	if len(annotations) > 0 {
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, cs.Bold("ANNOTATIONS"))
+		fmt.Fprintln(out, shared.RenderAnnotations(cs, annotations))
+	}
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package watch
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// rerunRun re-triggers every job in a run, mirroring `gh run rerun`.
+func rerunRun(client *api.Client, repo ghrepo.Interface, runID int64) error {
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/rerun", ghrepo.FullName(repo), runID)
+	return client.REST(repo.RepoHost(), "POST", path, nil, nil)
+}
+
+// cancelRun stops an in-progress run, mirroring `gh run cancel`.
+func cancelRun(client *api.Client, repo ghrepo.Interface, runID int64) error {
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/cancel", ghrepo.FullName(repo), runID)
+	return client.REST(repo.RepoHost(), "POST", path, nil, nil)
+}
@@ -0,0 +1,81 @@
+package watch
+
+import "github.com/cli/cli/pkg/cmd/run/shared"
+
+var runWatchFields = []string{"status", "conclusion", "createdAt", "updatedAt", "url", "jobs", "annotations"}
+
+type jobSnapshot struct {
+	Name       string  `json:"name"`
+	Status     string  `json:"status"`
+	Conclusion string  `json:"conclusion"`
+	Steps      int     `json:"steps"`
+	StepsDone  int     `json:"stepsDone"`
+	Duration   float64 `json:"durationSeconds"`
+}
+
+type annotationSnapshot struct {
+	JobName string `json:"jobName"`
+	Level   string `json:"level"`
+	Message string `json:"message"`
+}
+
+// runSnapshot is emitted once per poll cycle in --json streaming mode, so a
+// consumer piping `gh run watch --json` can drive a CI gate or dashboard
+// without parsing the TTY renderer's ANSI output.
+type runSnapshot struct {
+	run         *shared.Run
+	jobs        []shared.Job
+	annotations []shared.Annotation
+}
+
+func (s runSnapshot) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "status":
+			data["status"] = s.run.Status
+		case "conclusion":
+			data["conclusion"] = s.run.Conclusion
+		case "createdAt":
+			data["createdAt"] = s.run.CreatedAt
+		case "updatedAt":
+			data["updatedAt"] = s.run.UpdatedAt
+		case "url":
+			data["url"] = s.run.URL
+		case "jobs":
+			jobs := make([]jobSnapshot, len(s.jobs))
+			for i, job := range s.jobs {
+				done := 0
+				for _, step := range job.Steps {
+					if step.Status == shared.Completed {
+						done++
+					}
+				}
+				duration := job.CompletedAt.Sub(job.StartedAt).Seconds()
+				if duration < 0 {
+					duration = 0
+				}
+				jobs[i] = jobSnapshot{
+					Name:       job.Name,
+					Status:     string(job.Status),
+					Conclusion: string(job.Conclusion),
+					Steps:      len(job.Steps),
+					StepsDone:  done,
+					Duration:   duration,
+				}
+			}
+			data["jobs"] = jobs
+		case "annotations":
+			annotations := make([]annotationSnapshot, len(s.annotations))
+			for i, a := range s.annotations {
+				annotations[i] = annotationSnapshot{
+					JobName: a.JobName,
+					Level:   a.Level,
+					Message: a.Message,
+				}
+			}
+			data["annotations"] = annotations
+		}
+	}
+	return data
+}
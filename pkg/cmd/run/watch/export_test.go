@@ -0,0 +1,72 @@
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cli/cli/pkg/cmd/run/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRunSnapshot_ExportData(t *testing.T) {
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := runSnapshot{
+		run: &shared.Run{
+			Status:     shared.Completed,
+			Conclusion: shared.Failure,
+			CreatedAt:  start,
+			UpdatedAt:  start.Add(10 * time.Minute),
+			URL:        "https://github.com/OWNER/REPO/actions/runs/1",
+		},
+		jobs: []shared.Job{
+			{
+				Name:        "build",
+				Status:      shared.Completed,
+				Conclusion:  shared.Failure,
+				StartedAt:   start,
+				CompletedAt: start.Add(90 * time.Second),
+				Steps: []shared.Step{
+					{Status: shared.Completed},
+					{Status: shared.Completed},
+					{Status: shared.Queued},
+				},
+			},
+		},
+		annotations: []shared.Annotation{
+			{JobName: "build", Level: "failure", Message: "exit code 1"},
+		},
+	}
+
+	data := snapshot.ExportData([]string{"status", "conclusion", "url", "jobs", "annotations"})
+
+	assert.Equal(t, shared.Completed, data["status"])
+	assert.Equal(t, shared.Failure, data["conclusion"])
+	assert.Equal(t, "https://github.com/OWNER/REPO/actions/runs/1", data["url"])
+
+	jobs, ok := data["jobs"].([]jobSnapshot)
+	assert.True(t, ok)
+	assert.Equal(t, []jobSnapshot{{
+		Name:       "build",
+		Status:     string(shared.Completed),
+		Conclusion: string(shared.Failure),
+		Steps:      3,
+		StepsDone:  2,
+		Duration:   90,
+	}}, jobs)
+
+	annotations, ok := data["annotations"].([]annotationSnapshot)
+	assert.True(t, ok)
+	assert.Equal(t, []annotationSnapshot{{
+		JobName: "build",
+		Level:   "failure",
+		Message: "exit code 1",
+	}}, annotations)
+}
+
+func TestRunSnapshot_ExportData_OnlyRequestedFields(t *testing.T) {
+	snapshot := runSnapshot{run: &shared.Run{Status: shared.InProgress}}
+
+	data := snapshot.ExportData([]string{"status"})
+
+	assert.Equal(t, map[string]interface{}{"status": shared.InProgress}, data)
+}
@@ -0,0 +1,37 @@
+package watch
+
+import (
+	"os"
+
+	"golang.org/x/term"
+)
+
+// listenForKeys puts stdin into raw mode and streams single keypresses on
+// the returned channel so watchRunWithProgress can react to them (rerun,
+// cancel, open in browser, tail logs, quit) without waiting for Enter. The
+// returned restore func must be called before the program exits so the
+// terminal is left in cooked mode. If stdin isn't a real terminal, raw mode
+// can't be entered and the caller should fall back to ticker-only polling.
+func listenForKeys(stdin *os.File) (<-chan byte, func(), error) {
+	fd := int(stdin.Fd())
+	oldState, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, func() {}, err
+	}
+	restore := func() { _ = term.Restore(fd, oldState) }
+
+	keys := make(chan byte)
+	go func() {
+		defer close(keys)
+		buf := make([]byte, 1)
+		for {
+			n, err := stdin.Read(buf)
+			if err != nil || n == 0 {
+				return
+			}
+			keys <- buf[0]
+		}
+	}()
+
+	return keys, restore, nil
+}
@@ -0,0 +1,54 @@
+package watch
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghinstance"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// tailJobLog fetches only the bytes appended to a job's log since the last
+// call, using a Range request so we don't re-download the whole log on every
+// poll. offsets tracks the byte position per job across calls.
+func tailJobLog(client *api.Client, repo ghrepo.Interface, jobID int64, offsets map[int64]int64) ([]byte, error) {
+	url := fmt.Sprintf("%srepos/%s/actions/jobs/%d/logs", ghinstance.RESTPrefix(repo.RepoHost()), ghrepo.FullName(repo), jobID)
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	offset := offsets[jobID]
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := client.HTTP().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusPartialContent {
+		return nil, fmt.Errorf("unexpected status fetching job logs: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// A 200 reply to a ranged request carries the whole log; drop the part
+	// already returned by earlier calls.
+	if resp.StatusCode == http.StatusOK && offset > 0 {
+		if int64(len(body)) <= offset {
+			return nil, nil
+		}
+		body = body[offset:]
+	}
+
+	offsets[jobID] = offset + int64(len(body))
+	return body, nil
+}
@@ -0,0 +1,170 @@
+package watch
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/run/shared"
+	"github.com/vbauerster/mpb/v7"
+	"github.com/vbauerster/mpb/v7/decor"
+)
+
+const keybindingsFooter = "[r] rerun  [c] cancel  [o] open in browser  [l] tail next job  [q] quit"
+
+// watchRunWithProgress is the TTY renderer for `gh run watch`: one bar per
+// in-progress job, advancing as its steps complete, with the log of the
+// currently tailed job streamed underneath. While it polls, it also listens
+// for single-key commands (rerun, cancel, open in browser, switch the
+// tailed job, quit) so `watch` doubles as a lightweight control panel.
+func watchRunWithProgress(opts WatchOptions, client *api.Client, repo ghrepo.Interface, run *shared.Run, prNumber string) error {
+	cs := opts.IO.ColorScheme()
+	progress := mpb.New(mpb.WithOutput(opts.IO.Out), mpb.WithWidth(40))
+	bars := map[int64]*mpb.Bar{}
+	offsets := map[int64]int64{}
+	tailIdx := 0
+	autoTail := true
+
+	var keys <-chan byte
+	if opts.IO.IsStdinTTY() {
+		if k, restore, err := listenForKeys(os.Stdin); err == nil {
+			keys = k
+			defer restore()
+		}
+	}
+
+	fmt.Fprintln(opts.IO.Out, cs.Boldf("Refreshing run status every %s.", opts.Interval))
+	fmt.Fprintln(opts.IO.Out, cs.Gray(keybindingsFooter))
+
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		var err error
+		run, err = shared.GetRun(client, repo, fmt.Sprintf("%d", run.ID))
+		if err != nil {
+			return fmt.Errorf("failed to get run: %w", err)
+		}
+
+		jobs, err := shared.GetJobs(client, repo, *run)
+		if err != nil {
+			return fmt.Errorf("failed to get jobs: %w", err)
+		}
+
+		for _, job := range jobs {
+			bar, ok := bars[job.ID]
+			if !ok {
+				if job.Status != shared.InProgress {
+					continue
+				}
+				bar = progress.AddBar(int64(len(job.Steps)),
+					mpb.PrependDecorators(decor.Name(job.Name, decor.WC{W: 30, C: decor.DindentRight})),
+					mpb.AppendDecorators(decor.CountersNoUnit("%d / %d steps")),
+				)
+				bars[job.ID] = bar
+			}
+
+			completed := int64(0)
+			for _, step := range job.Steps {
+				if step.Status == shared.Completed {
+					completed++
+				}
+			}
+			bar.SetCurrent(completed)
+		}
+
+		// Follow whichever job is actually running instead of sitting on a
+		// fixed index: left alone, that's almost always whatever job
+		// happened to sort first in the API response, not the one
+		// producing output. Once the user picks a job with `l`, respect it
+		// instead of yanking them back to the in-progress job every poll.
+		if autoTail {
+			for i, job := range jobs {
+				if job.Status == shared.InProgress {
+					tailIdx = i
+					break
+				}
+			}
+		}
+
+		if len(jobs) > 0 {
+			job := jobs[tailIdx%len(jobs)]
+			if newBytes, err := tailJobLog(client, repo, job.ID, offsets); err == nil && len(newBytes) > 0 {
+				// Print straight to the same writer mpb renders bars to.
+				// Wait() must not be called here: it blocks until every bar
+				// reaches its target, and this goroutine is the only one
+				// that ever advances them, so calling it mid-loop deadlocks
+				// as soon as there's any log output to show. It's only safe
+				// once, after the loop that drives the bars, below.
+				fmt.Fprint(opts.IO.Out, string(newBytes))
+			}
+		}
+
+		if run.Status == shared.Completed {
+			break
+		}
+
+		select {
+		case <-ticker.C:
+		case key, ok := <-keys:
+			if !ok {
+				continue
+			}
+			done, err := handleKey(opts, client, repo, run, key, jobs, &tailIdx, &autoTail)
+			if err != nil {
+				fmt.Fprintf(opts.IO.ErrOut, "failed to handle keypress: %v\n", err)
+			}
+			if done {
+				return nil
+			}
+		}
+	}
+
+	progress.Wait()
+	fmt.Fprintln(opts.IO.Out)
+	fmt.Fprintln(opts.IO.Out, shared.RenderRunHeader(cs, *run, "", prNumber))
+
+	// rerunRun only succeeds once the run has actually completed, so the `r`
+	// keybinding advertised above would never work if we stopped reading
+	// keys the moment the bars finished. Keep taking keypresses until the
+	// user quits.
+	for keys != nil {
+		key, ok := <-keys
+		if !ok {
+			return nil
+		}
+		done, err := handleKey(opts, client, repo, run, key, nil, &tailIdx, &autoTail)
+		if err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "failed to handle keypress: %v\n", err)
+		}
+		if done {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// handleKey reacts to a single keypress from listenForKeys. It returns
+// done=true when the user asked to quit.
+func handleKey(opts WatchOptions, client *api.Client, repo ghrepo.Interface, run *shared.Run, key byte, jobs []shared.Job, tailIdx *int, autoTail *bool) (bool, error) {
+	switch key {
+	case 'q', 0x03: // q or Ctrl+C
+		return true, nil
+	case 'r':
+		return false, rerunRun(client, repo, run.ID)
+	case 'c':
+		return false, cancelRun(client, repo, run.ID)
+	case 'o':
+		return false, opts.OpenInBrowser(run.URL)
+	case 'l':
+		if len(jobs) > 0 {
+			*autoTail = false
+			*tailIdx = (*tailIdx + 1) % len(jobs)
+		}
+		return false, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,57 @@
+package watch
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/run/shared"
+)
+
+// watchRunJSON streams one newline-delimited JSON object per poll cycle
+// instead of redrawing the TTY renderer. It's used whenever --json (or any
+// of --jq/--template) is set, and by default for non-TTY stdout so piping
+// `gh run watch` into another program never leaks ANSI clear-screen codes.
+func watchRunJSON(opts WatchOptions, client *api.Client, repo ghrepo.Interface, run *shared.Run) error {
+	for {
+		run, err := shared.GetRun(client, repo, fmt.Sprintf("%d", run.ID))
+		if err != nil {
+			return fmt.Errorf("failed to get run: %w", err)
+		}
+
+		jobs, err := shared.GetJobs(client, repo, *run)
+		if err != nil {
+			return fmt.Errorf("failed to get jobs: %w", err)
+		}
+
+		var annotations []shared.Annotation
+		for _, job := range jobs {
+			as, err := shared.GetAnnotations(client, repo, job)
+			if err != nil {
+				return fmt.Errorf("failed to get annotations: %w", err)
+			}
+			annotations = append(annotations, as...)
+		}
+
+		snapshot := runSnapshot{run: run, jobs: jobs, annotations: annotations}
+		if opts.Exporter != nil {
+			if err := opts.Exporter.Write(opts.IO, snapshot); err != nil {
+				return err
+			}
+		} else {
+			data := snapshot.ExportData(runWatchFields)
+			enc := json.NewEncoder(opts.IO.Out)
+			if err := enc.Encode(data); err != nil {
+				return err
+			}
+		}
+
+		if run.Status == shared.Completed {
+			return nil
+		}
+
+		time.Sleep(opts.Interval)
+	}
+}
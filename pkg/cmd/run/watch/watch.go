@@ -16,25 +16,32 @@ import (
 )
 
 type WatchOptions struct {
-	IO         *iostreams.IOStreams
-	HttpClient func() (*http.Client, error)
-	BaseRepo   func() (ghrepo.Interface, error)
+	IO            *iostreams.IOStreams
+	HttpClient    func() (*http.Client, error)
+	BaseRepo      func() (ghrepo.Interface, error)
+	OpenInBrowser func(string) error
+
+	Exporter cmdutil.Exporter
 
 	RunID    string
-	Interval int
+	Interval time.Duration
 
 	Prompt bool
+	Latest bool
 
-	Now func() time.Time
+	WorkflowSelector string
+	Branch           string
+	Event            string
+	User             string
+	Status           string
 }
 
 func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Command {
 	opts := &WatchOptions{
-		IO:         f.IOStreams,
-		HttpClient: f.HttpClient,
-		Now:        time.Now,
-		// TODO allow setting via flag?
-		Interval: 2,
+		IO:            f.IOStreams,
+		HttpClient:    f.HttpClient,
+		OpenInBrowser: utils.OpenInBrowser,
+		Interval:      2 * time.Second,
 	}
 
 	cmd := &cobra.Command{
@@ -46,13 +53,23 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 			opts.BaseRepo = f.BaseRepo
 
 			if len(args) > 0 {
-				opts.RunID = args[0]
+				if args[0] == "latest" {
+					opts.Latest = true
+				} else {
+					opts.RunID = args[0]
+				}
+			} else if opts.Latest {
+				// no-op, resolved below
 			} else if !opts.IO.CanPrompt() {
 				return &cmdutil.FlagError{Err: errors.New("run ID required when not running interactively")}
 			} else {
 				opts.Prompt = true
 			}
 
+			if opts.Interval <= 0 {
+				return &cmdutil.FlagError{Err: errors.New("interval must be greater than 0")}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -61,6 +78,16 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 		},
 	}
 
+	cmd.Flags().DurationVarP(&opts.Interval, "interval", "i", 2*time.Second, "Refresh interval")
+	cmd.Flags().BoolVar(&opts.Latest, "latest", false, "Watch the most recent run")
+	cmd.Flags().StringVar(&opts.WorkflowSelector, "workflow", "", "Filter the latest run by workflow")
+	cmd.Flags().StringVar(&opts.Branch, "branch", "", "Filter the latest run by branch")
+	cmd.Flags().StringVar(&opts.Event, "event", "", "Filter the latest run by event type")
+	cmd.Flags().StringVar(&opts.User, "user", "", "Filter the latest run by the user who triggered it")
+	cmd.Flags().StringVar(&opts.Status, "status", "", "Filter the latest run by status or conclusion")
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, runWatchFields)
+
 	return cmd
 }
 
@@ -78,7 +105,12 @@ func watchRun(opts *WatchOptions) error {
 
 	runID := opts.RunID
 
-	if opts.Prompt {
+	if opts.Latest {
+		runID, err = shared.LatestRunID(client, repo, opts.WorkflowSelector, opts.Branch, opts.Event, opts.User, opts.Status)
+		if err != nil {
+			return fmt.Errorf("failed to find the latest run: %w", err)
+		}
+	} else if opts.Prompt {
 		cs := opts.IO.ColorScheme()
 		runID, err = shared.PromptForRun(cs, client, repo)
 		if err != nil {
@@ -98,83 +130,18 @@ func watchRun(opts *WatchOptions) error {
 		prNumber = fmt.Sprintf(" #%d", number)
 	}
 
-	// clear entire screen
-	fmt.Fprint(opts.IO.Out, "\033[2J")
-
-	for run.Status != shared.Completed {
-		run, err = renderRun(*opts, client, repo, run, prNumber)
-		if err != nil {
-			return err
-		}
-		time.Sleep(time.Duration(opts.Interval * 1000))
+	if shouldStreamJSON(opts) {
+		return watchRunJSON(*opts, client, repo, run)
 	}
 
-	return nil
+	return watchRunWithProgress(*opts, client, repo, run, prNumber)
 }
 
-func renderRun(opts WatchOptions, client *api.Client, repo ghrepo.Interface, run *shared.Run, prNumber string) (*shared.Run, error) {
-	out := opts.IO.Out
-	cs := opts.IO.ColorScheme()
-
-	var err error
-
-	run, err = shared.GetRun(client, repo, fmt.Sprintf("%d", run.ID))
-	if err != nil {
-		return run, fmt.Errorf("failed to get run: %w", err)
-	}
-
-	ago := opts.Now().Sub(run.CreatedAt)
-
-	jobs, err := shared.GetJobs(client, repo, *run)
-	if err != nil {
-		return run, fmt.Errorf("failed to get jobs: %w", err)
-	}
-
-	var annotations []shared.Annotation
-
-	var annotationErr error
-	var as []shared.Annotation
-	for _, job := range jobs {
-		as, annotationErr = shared.GetAnnotations(client, repo, job)
-		if annotationErr != nil {
-			break
-		}
-		annotations = append(annotations, as...)
-	}
-
-	if annotationErr != nil {
-		return run, fmt.Errorf("failed to get annotations: %w", annotationErr)
-	}
-
-	// Move cursor to 0,0
-	fmt.Fprint(opts.IO.Out, "\033[0;0H")
-	// Clear from cursor to bottom of screen
-	fmt.Fprint(opts.IO.Out, "\033[J")
-
-	fmt.Fprintln(out, cs.Boldf("Refreshing run status every %d seconds. Press Ctrl+C to quit.", opts.Interval))
-	fmt.Fprintln(out)
-	fmt.Fprintln(out, shared.RenderRunHeader(cs, *run, utils.FuzzyAgo(ago), prNumber))
-	fmt.Fprintln(out)
-
-	if len(jobs) == 0 && run.Conclusion == shared.Failure {
-		// TODO are we supporting exit status here?
-		return run, nil
-	}
-
-	fmt.Fprintln(out, cs.Bold("JOBS"))
-
-	fmt.Fprintln(out, shared.RenderJobs(cs, jobs, true))
-
-	if len(annotations) > 0 {
-		fmt.Fprintln(out)
-		fmt.Fprintln(out, cs.Bold("ANNOTATIONS"))
-		fmt.Fprintln(out, shared.RenderAnnotations(cs, annotations))
-	}
-
-	// TODO supporting exit status?
-	//if opts.ExitStatus && shared.IsFailureState(run.Conclusion) {
-	//	return cmdutil.SilentError
-	//}
-
-	return run, nil
+// shouldStreamJSON reports whether `gh run watch` should emit newline-delimited
+// JSON instead of driving the TTY progress renderer: either the user asked for
+// machine-readable output explicitly (--json/--jq/--template), or stdout isn't
+// a terminal at all, in which case drawing progress bars would just spew ANSI
+// codes into a pipe.
+func shouldStreamJSON(opts *WatchOptions) bool {
+	return opts.Exporter != nil || !opts.IO.IsStdoutTTY()
 }
@@ -0,0 +1,52 @@
+package watch
+
+import (
+	"testing"
+
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeExporter is a minimal cmdutil.Exporter stand-in; only its presence
+// (non-nil) matters to shouldStreamJSON.
+type fakeExporter struct{}
+
+func (fakeExporter) Fields() []string                                      { return nil }
+func (fakeExporter) Write(io *iostreams.IOStreams, data interface{}) error { return nil }
+
+func TestShouldStreamJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		exporter cmdutil.Exporter
+		ttyOut   bool
+		want     bool
+	}{
+		{
+			name:   "TTY stdout, no exporter uses the progress renderer",
+			ttyOut: true,
+			want:   false,
+		},
+		{
+			name:   "non-TTY stdout streams JSON",
+			ttyOut: false,
+			want:   true,
+		},
+		{
+			name:     "exporter set streams JSON even on a TTY",
+			exporter: fakeExporter{},
+			ttyOut:   true,
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.ttyOut)
+
+			opts := &WatchOptions{IO: io, Exporter: tt.exporter}
+			assert.Equal(t, tt.want, shouldStreamJSON(opts))
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package init
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"gopkg.in/yaml.v3"
+)
+
+func jsonReader(v interface{}) (io.Reader, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(b), nil
+}
+
+// validateWorkflowYAML does a minimal structural check so we don't write or
+// commit an obviously broken workflow file. Deeper linting lives in the
+// `gh workflow lint` command.
+func validateWorkflowYAML(content []byte) error {
+	var doc map[string]interface{}
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return err
+	}
+	for _, key := range []string{"on", "jobs"} {
+		if _, ok := doc[key]; !ok {
+			return fmt.Errorf("missing required top-level key %q", key)
+		}
+	}
+	return nil
+}
+
+// createWorkflowPR commits the rendered workflow file to a new branch via
+// the contents API and opens a pull request against the repo's default
+// branch, mirroring how other `gh` commands push a single generated file
+// without requiring a local git checkout.
+func createWorkflowPR(opts *InitOptions, filename string, content []byte) error {
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not build http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("could not determine base repo: %w", err)
+	}
+
+	opts.IO.StartProgressIndicator()
+	defer opts.IO.StopProgressIndicator()
+
+	baseBranch, err := api.RepoDefaultBranch(client, repo)
+	if err != nil {
+		return fmt.Errorf("could not determine default branch: %w", err)
+	}
+
+	baseRef, err := getRef(client, repo, baseBranch)
+	if err != nil {
+		return fmt.Errorf("could not look up base branch: %w", err)
+	}
+
+	branch := fmt.Sprintf("gh-workflow-init/%s-%d", slugify(filename), time.Now().Unix())
+	if err := createRef(client, repo, branch, baseRef); err != nil {
+		return fmt.Errorf("could not create branch: %w", err)
+	}
+
+	filePath := path.Join(".github", "workflows", filename)
+
+	existingSHA, err := getFileSHA(client, repo, filePath, baseBranch)
+	if err != nil {
+		return fmt.Errorf("could not check for an existing %s: %w", filePath, err)
+	}
+	if existingSHA != "" && !opts.Force {
+		return fmt.Errorf("%s already exists on %s; use --force to overwrite", filePath, baseBranch)
+	}
+
+	message := fmt.Sprintf("Add %s workflow", filename)
+	if err := putFile(client, repo, filePath, branch, existingSHA, message, content); err != nil {
+		return fmt.Errorf("could not commit workflow file: %w", err)
+	}
+
+	url, err := createPullRequest(client, repo, branch, baseBranch, message)
+	if err != nil {
+		return fmt.Errorf("could not create pull request: %w", err)
+	}
+
+	opts.IO.StopProgressIndicator()
+	fmt.Fprintf(opts.IO.Out, "%s\n", url)
+	return nil
+}
+
+func slugify(filename string) string {
+	name := filename
+	for _, r := range []string{".yml", ".yaml"} {
+		if len(name) > len(r) && name[len(name)-len(r):] == r {
+			name = name[:len(name)-len(r)]
+		}
+	}
+	return name
+}
+
+func getRef(client *api.Client, repo ghrepo.Interface, branch string) (string, error) {
+	type ref struct {
+		Object struct {
+			SHA string `json:"sha"`
+		} `json:"object"`
+	}
+	var result ref
+	p := fmt.Sprintf("repos/%s/git/ref/heads/%s", ghrepo.FullName(repo), branch)
+	if err := client.REST(repo.RepoHost(), "GET", p, nil, &result); err != nil {
+		return "", err
+	}
+	return result.Object.SHA, nil
+}
+
+func createRef(client *api.Client, repo ghrepo.Interface, branch, sha string) error {
+	payload := map[string]string{
+		"ref": fmt.Sprintf("refs/heads/%s", branch),
+		"sha": sha,
+	}
+	body, err := jsonReader(payload)
+	if err != nil {
+		return err
+	}
+	p := fmt.Sprintf("repos/%s/git/refs", ghrepo.FullName(repo))
+	return client.REST(repo.RepoHost(), "POST", p, body, nil)
+}
+
+// getFileSHA returns the blob SHA of filePath at ref, or "" if it doesn't
+// exist there. The contents API requires that SHA to update an existing
+// file, and its absence is how we tell a fresh file from an overwrite.
+func getFileSHA(client *api.Client, repo ghrepo.Interface, filePath, ref string) (string, error) {
+	type content struct {
+		SHA string `json:"sha"`
+	}
+	var result content
+	p := fmt.Sprintf("repos/%s/contents/%s?ref=%s", ghrepo.FullName(repo), filePath, ref)
+	err := client.REST(repo.RepoHost(), "GET", p, nil, &result)
+	if err != nil {
+		var httpErr api.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	return result.SHA, nil
+}
+
+func putFile(client *api.Client, repo ghrepo.Interface, filePath, branch, sha, message string, content []byte) error {
+	payload := map[string]string{
+		"message": message,
+		"content": base64.StdEncoding.EncodeToString(content),
+		"branch":  branch,
+	}
+	if sha != "" {
+		payload["sha"] = sha
+	}
+	body, err := jsonReader(payload)
+	if err != nil {
+		return err
+	}
+	p := fmt.Sprintf("repos/%s/contents/%s", ghrepo.FullName(repo), filePath)
+	return client.REST(repo.RepoHost(), "PUT", p, body, nil)
+}
+
+func createPullRequest(client *api.Client, repo ghrepo.Interface, head, base, title string) (string, error) {
+	payload := map[string]string{
+		"title": title,
+		"head":  head,
+		"base":  base,
+	}
+	body, err := jsonReader(payload)
+	if err != nil {
+		return "", err
+	}
+
+	type pr struct {
+		HTMLURL string `json:"html_url"`
+	}
+	var result pr
+	p := fmt.Sprintf("repos/%s/pulls", ghrepo.FullName(repo))
+	if err := client.REST(repo.RepoHost(), "POST", p, body, &result); err != nil {
+		return "", err
+	}
+	return result.HTMLURL, nil
+}
@@ -0,0 +1,196 @@
+package init
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/cli/cli/pkg/prompt"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type InitOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Template string
+	Name     string
+	Filename string
+	Force    bool
+	PR       bool
+
+	Prompt bool
+}
+
+func NewCmdInit(f *cmdutil.Factory, runF func(*InitOptions) error) *cobra.Command {
+	opts := &InitOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:    "init",
+		Short:  "Scaffold a new workflow file",
+		Hidden: true,
+		Long: heredoc.Doc(`
+			Create a starter workflow file under .github/workflows/ from a catalog
+			of templates (Go, Node.js, Python, Docker publish, generic CI,
+			release-please, and more).
+
+			When run interactively, you'll be prompted to pick a template and a
+			name. Non-interactively, --template and --name are required.
+		`),
+		Example: heredoc.Doc(`
+			# Pick a template interactively
+			$ gh workflow init
+
+			# Scaffold a Go workflow non-interactively
+			$ gh workflow init --template go --name "Go"
+
+			# Scaffold and open a pull request with the new file
+			$ gh workflow init --template go --name "Go" --pr
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if !opts.IO.CanPrompt() {
+				if opts.Template == "" || opts.Name == "" {
+					return &cmdutil.FlagError{Err: errors.New("--template and --name required when not running interactively")}
+				}
+			} else if opts.Template == "" {
+				opts.Prompt = true
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return initRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Template, "template", "t", "", fmt.Sprintf("Template to scaffold: %s", strings.Join(templateNames(), ", ")))
+	cmd.Flags().StringVarP(&opts.Name, "name", "n", "", "Name for the new workflow")
+	cmd.Flags().StringVarP(&opts.Filename, "filename", "f", "", "Filename to write under .github/workflows/ (defaults to the template's filename)")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Overwrite the destination file if it already exists")
+	cmd.Flags().BoolVar(&opts.PR, "pr", false, "Commit the workflow via the API and open a pull request instead of writing it locally")
+
+	return cmd
+}
+
+func initRun(opts *InitOptions) error {
+	tmpl, err := resolveTemplate(opts)
+	if err != nil {
+		return err
+	}
+
+	name := opts.Name
+	if name == "" {
+		name = tmpl.Name
+	}
+
+	filename := opts.Filename
+	if filename == "" {
+		filename = tmpl.Filename
+	}
+	if filepath.Ext(filename) == "" {
+		filename += ".yml"
+	}
+
+	content, err := tmpl.contents()
+	if err != nil {
+		return fmt.Errorf("could not load template: %w", err)
+	}
+	content, err = applyName(content, name)
+	if err != nil {
+		return fmt.Errorf("could not render template: %w", err)
+	}
+	if err := validateWorkflowYAML(content); err != nil {
+		return fmt.Errorf("generated workflow is not valid: %w", err)
+	}
+
+	if opts.PR {
+		return createWorkflowPR(opts, filename, content)
+	}
+
+	return writeWorkflowFile(opts, filename, content)
+}
+
+func resolveTemplate(opts *InitOptions) (*Template, error) {
+	if opts.Prompt {
+		names := templateNames()
+		var key string
+		err := prompt.SurveyAskOne(&prompt.SurveySelect{
+			Message: "Which template would you like to use?",
+			Options: names,
+		}, &key)
+		if err != nil {
+			return nil, fmt.Errorf("could not prompt: %w", err)
+		}
+		opts.Template = key
+
+		if opts.Name == "" {
+			err := prompt.SurveyAskOne(&prompt.SurveyInput{
+				Message: "What should the workflow be called?",
+			}, &opts.Name)
+			if err != nil {
+				return nil, fmt.Errorf("could not prompt: %w", err)
+			}
+		}
+	}
+
+	return templateByKey(opts.Template)
+}
+
+func applyName(content []byte, name string) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return content, nil
+	}
+	root := doc.Content[0]
+	for i := 0; i < len(root.Content)-1; i += 2 {
+		if root.Content[i].Value == "name" {
+			root.Content[i+1].Value = name
+			break
+		}
+	}
+	return yaml.Marshal(&doc)
+}
+
+func writeWorkflowFile(opts *InitOptions, filename string, content []byte) error {
+	dir := filepath.Join(".github", "workflows")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("could not create %s: %w", dir, err)
+	}
+
+	path := filepath.Join(dir, filename)
+	if !opts.Force {
+		if _, err := os.Stat(path); err == nil {
+			return fmt.Errorf("%s already exists; use --force to overwrite", path)
+		}
+	}
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("could not write %s: %w", path, err)
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.Out, "%s Created workflow %s\n", cs.SuccessIcon(), path)
+	}
+
+	return nil
+}
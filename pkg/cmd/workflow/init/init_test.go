@@ -0,0 +1,53 @@
+package init
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestApplyName(t *testing.T) {
+	for _, tmpl := range catalog {
+		t.Run(tmpl.Key, func(t *testing.T) {
+			content, err := tmpl.contents()
+			require.NoError(t, err)
+
+			rendered, err := applyName(content, "My Workflow")
+			require.NoError(t, err)
+
+			var doc map[string]interface{}
+			require.NoError(t, yaml.Unmarshal(rendered, &doc))
+			assert.Equal(t, "My Workflow", doc["name"])
+		})
+	}
+}
+
+func TestWriteWorkflowFile_ForceRequiredToOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	defer os.Chdir(wd)
+
+	io, _, _, _ := iostreams.Test()
+	opts := &InitOptions{IO: io}
+
+	require.NoError(t, writeWorkflowFile(opts, "ci.yml", []byte("name: CI\n")))
+
+	err = writeWorkflowFile(opts, "ci.yml", []byte("name: CI again\n"))
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "use --force to overwrite"))
+
+	opts.Force = true
+	require.NoError(t, writeWorkflowFile(opts, "ci.yml", []byte("name: CI again\n")))
+
+	written, err := os.ReadFile(filepath.Join(dir, ".github", "workflows", "ci.yml"))
+	require.NoError(t, err)
+	assert.Equal(t, "name: CI again\n", string(written))
+}
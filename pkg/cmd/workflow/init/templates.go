@@ -0,0 +1,50 @@
+package init
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+)
+
+//go:embed templates/*.yml
+var templatesFS embed.FS
+
+// Template describes one starter workflow that `gh workflow init` can scaffold.
+type Template struct {
+	Key         string
+	Name        string
+	Description string
+	Filename    string
+}
+
+var catalog = []Template{
+	{Key: "go", Name: "Go", Description: "Build and test a Go module", Filename: "go.yml"},
+	{Key: "node", Name: "Node.js", Description: "Install dependencies and run npm test", Filename: "node.yml"},
+	{Key: "python", Name: "Python", Description: "Install dependencies and run pytest", Filename: "python.yml"},
+	{Key: "docker-publish", Name: "Docker publish", Description: "Build and push a Docker image", Filename: "docker-publish.yml"},
+	{Key: "ci", Name: "Generic CI", Description: "A minimal build/test workflow you can adapt", Filename: "ci.yml"},
+	{Key: "release-please", Name: "release-please", Description: "Automate releases with release-please", Filename: "release-please.yml"},
+}
+
+func templateByKey(key string) (*Template, error) {
+	for _, t := range catalog {
+		if t.Key == key {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("no such template %q", key)
+}
+
+func templateNames() []string {
+	names := make([]string, len(catalog))
+	for i, t := range catalog {
+		names[i] = t.Key
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (t Template) contents() ([]byte, error) {
+	return fs.ReadFile(templatesFS, "templates/"+t.Filename)
+}
@@ -0,0 +1,239 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var shaRefRE = regexp.MustCompile(`^[0-9a-f]{40}$`)
+var exprRE = regexp.MustCompile(`\$\{\{.*?\}\}`)
+var secretRefRE = regexp.MustCompile(`secrets\.([A-Za-z_][A-Za-z0-9_]*)`)
+
+var knownRunners = map[string]bool{
+	"ubuntu-latest": true, "ubuntu-22.04": true, "ubuntu-20.04": true,
+	"macos-latest": true, "macos-13": true, "macos-12": true,
+	"windows-latest": true, "windows-2022": true, "windows-2019": true,
+}
+
+// Check runs a best-effort, offline lint pass over a workflow file's raw
+// YAML: required top-level keys, runs-on sanity, a needs: DAG with no
+// cycles or dangling references, balanced ${{ }} expressions, uses: refs
+// pinned to a SHA or tag, and secrets that don't appear in knownSecrets
+// (other than GITHUB_TOKEN; the secret check is skipped when knownSecrets is
+// empty). It's deliberately not a full actionlint reimplementation - just enough to
+// catch the mistakes that show up most often in hand-written workflows.
+func Check(content []byte, knownSecrets []string) ([]Finding, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, fmt.Errorf("invalid YAML: %w", err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("empty workflow file")
+	}
+	root := doc.Content[0]
+
+	var findings []Finding
+
+	onNode := mapValue(root, "on")
+	if onNode == nil {
+		findings = append(findings, errorAt(root, "schema", `missing required top-level key "on"`))
+	}
+
+	jobsNode := mapValue(root, "jobs")
+	if jobsNode == nil {
+		findings = append(findings, errorAt(root, "schema", `missing required top-level key "jobs"`))
+		return findings, nil
+	}
+
+	jobNames := map[string]*yaml.Node{}
+	for i := 0; i+1 < len(jobsNode.Content); i += 2 {
+		jobNames[jobsNode.Content[i].Value] = jobsNode.Content[i+1]
+	}
+
+	findings = append(findings, checkNeedsDAG(jobsNode, jobNames)...)
+
+	for name, job := range jobNames {
+		findings = append(findings, checkJob(name, job, knownSecrets)...)
+	}
+
+	return findings, nil
+}
+
+func checkJob(name string, job *yaml.Node, knownSecrets []string) []Finding {
+	var findings []Finding
+
+	if runsOn := mapValue(job, "runs-on"); runsOn != nil && runsOn.Kind == yaml.ScalarNode {
+		if !knownRunners[runsOn.Value] && !strings.HasPrefix(runsOn.Value, "self-hosted") {
+			findings = append(findings, warnAt(runsOn, "runs-on",
+				fmt.Sprintf("job %q uses unrecognized runs-on value %q", name, runsOn.Value)))
+		}
+	}
+
+	if stepsNode := mapValue(job, "steps"); stepsNode != nil {
+		for _, step := range stepsNode.Content {
+			if uses := mapValue(step, "uses"); uses != nil && uses.Kind == yaml.ScalarNode {
+				findings = append(findings, checkUsesRef(name, uses)...)
+			}
+		}
+	}
+
+	findings = append(findings, checkExpressions(job, knownSecrets)...)
+
+	return findings
+}
+
+func checkUsesRef(jobName string, uses *yaml.Node) []Finding {
+	parts := strings.SplitN(uses.Value, "@", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return []Finding{errorAt(uses, "uses-unpinned",
+			fmt.Sprintf("job %q step %q does not pin a version: use owner/repo@<sha-or-tag>", jobName, uses.Value))}
+	}
+	ref := parts[1]
+	if shaRefRE.MatchString(ref) || strings.HasPrefix(ref, "v") {
+		return nil
+	}
+	return []Finding{warnAt(uses, "uses-unpinned",
+		fmt.Sprintf("job %q step %q is not pinned to a full commit SHA or a version tag", jobName, uses.Value))}
+}
+
+func checkExpressions(node *yaml.Node, knownSecrets []string) []Finding {
+	var findings []Finding
+	known := map[string]bool{}
+	for _, s := range knownSecrets {
+		known[s] = true
+	}
+
+	var walk func(n *yaml.Node)
+	walk = func(n *yaml.Node) {
+		if n.Kind == yaml.ScalarNode {
+			if strings.Count(n.Value, "${{") != len(exprRE.FindAllString(n.Value, -1)) {
+				findings = append(findings, errorAt(n, "expression-syntax",
+					fmt.Sprintf("unbalanced ${{ }} expression: %q", n.Value)))
+			}
+			if len(knownSecrets) > 0 {
+				for _, m := range secretRefRE.FindAllStringSubmatch(n.Value, -1) {
+					name := m[1]
+					if name == "GITHUB_TOKEN" {
+						continue
+					}
+					if !known[name] {
+						findings = append(findings, errorAt(n, "unknown-secret",
+							fmt.Sprintf("secret %q is referenced but not defined on this repository", name)))
+					}
+				}
+			}
+		}
+		for _, c := range n.Content {
+			walk(c)
+		}
+	}
+	walk(node)
+
+	return findings
+}
+
+// checkNeedsDAG validates that every `needs:` entry refers to a real job and
+// that following `needs` edges never cycles back to the starting job.
+func checkNeedsDAG(jobsNode *yaml.Node, jobNames map[string]*yaml.Node) []Finding {
+	var findings []Finding
+	edges := map[string][]string{}
+
+	for i := 0; i+1 < len(jobsNode.Content); i += 2 {
+		name := jobsNode.Content[i].Value
+		job := jobsNode.Content[i+1]
+		needs := mapValue(job, "needs")
+		if needs == nil {
+			continue
+		}
+		var deps []string
+		switch needs.Kind {
+		case yaml.ScalarNode:
+			deps = []string{needs.Value}
+		case yaml.SequenceNode:
+			for _, c := range needs.Content {
+				deps = append(deps, c.Value)
+			}
+		}
+		for _, dep := range deps {
+			if _, ok := jobNames[dep]; !ok {
+				findings = append(findings, errorAt(needs, "needs-dangling",
+					fmt.Sprintf("job %q needs undefined job %q", name, dep)))
+				continue
+			}
+			edges[name] = append(edges[name], dep)
+		}
+	}
+
+	// visited marks jobs whose whole subtree has been fully explored, so we
+	// never redo that work. onPath marks only the jobs on the current DFS
+	// stack; unlike a job merely reachable into a cycle, a job that's part
+	// of the cycle itself will still be onPath when we walk back into it, so
+	// that - not "ever seen" - is what tells a cycle member apart from an
+	// innocent ancestor of one.
+	visited := map[string]bool{}
+	onPath := map[string]bool{}
+	cyclic := map[string]bool{}
+
+	var visit func(name string, path []string)
+	visit = func(name string, path []string) {
+		if visited[name] {
+			return
+		}
+		if onPath[name] {
+			for i := len(path) - 1; i >= 0 && path[i] != name; i-- {
+				cyclic[path[i]] = true
+			}
+			cyclic[name] = true
+			return
+		}
+		onPath[name] = true
+		path = append(path, name)
+		for _, dep := range edges[name] {
+			visit(dep, path)
+		}
+		onPath[name] = false
+		visited[name] = true
+	}
+
+	for name := range jobNames {
+		visit(name, nil)
+	}
+
+	names := make([]string, 0, len(jobNames))
+	for name := range jobNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if cyclic[name] {
+			findings = append(findings, errorAt(jobsNode, "needs-cycle",
+				fmt.Sprintf("job %q is part of a needs: cycle", name)))
+		}
+	}
+
+	return findings
+}
+
+func mapValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func errorAt(n *yaml.Node, rule, message string) Finding {
+	return Finding{Rule: rule, Severity: SeverityError, Message: message, Line: n.Line, Column: n.Column}
+}
+
+func warnAt(n *yaml.Node, rule, message string) Finding {
+	return Finding{Rule: rule, Severity: SeverityWarning, Message: message, Line: n.Line, Column: n.Column}
+}
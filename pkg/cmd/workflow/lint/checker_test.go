@@ -0,0 +1,125 @@
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func findingsByRule(findings []Finding, rule string) []Finding {
+	var out []Finding
+	for _, f := range findings {
+		if f.Rule == rule {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+func TestCheck_NeedsCycleOnlyFlagsCycleMembers(t *testing.T) {
+	// b -> c -> d -> b is a cycle; a merely needs b, and e is untouched.
+	// Only b, c, and d should be reported - not a, which is just reachable
+	// into the cycle, and not e, which has nothing to do with it.
+	content := []byte(`
+on: push
+jobs:
+  a:
+    runs-on: ubuntu-latest
+    needs: b
+    steps: []
+  b:
+    runs-on: ubuntu-latest
+    needs: c
+    steps: []
+  c:
+    runs-on: ubuntu-latest
+    needs: d
+    steps: []
+  d:
+    runs-on: ubuntu-latest
+    needs: b
+    steps: []
+  e:
+    runs-on: ubuntu-latest
+    steps: []
+`)
+
+	findings, err := Check(content, nil)
+	require.NoError(t, err)
+
+	cycle := findingsByRule(findings, "needs-cycle")
+	var reported []string
+	for _, f := range cycle {
+		reported = append(reported, f.Message)
+	}
+
+	assert.Len(t, cycle, 3)
+	for _, name := range []string{`"b"`, `"c"`, `"d"`} {
+		found := false
+		for _, msg := range reported {
+			if strings.Contains(msg, name) {
+				found = true
+			}
+		}
+		assert.True(t, found, "expected a needs-cycle finding naming %s, got %v", name, reported)
+	}
+	for _, name := range []string{`"a"`, `"e"`} {
+		for _, msg := range reported {
+			assert.False(t, strings.Contains(msg, name), "job %s should not be reported as part of the cycle", name)
+		}
+	}
+}
+
+func TestCheck_NeedsDangling(t *testing.T) {
+	content := []byte(`
+on: push
+jobs:
+  a:
+    runs-on: ubuntu-latest
+    needs: nonexistent
+    steps: []
+`)
+
+	findings, err := Check(content, nil)
+	require.NoError(t, err)
+
+	dangling := findingsByRule(findings, "needs-dangling")
+	assert.Len(t, dangling, 1)
+}
+
+func TestCheck_ExpressionSyntax(t *testing.T) {
+	content := []byte(`
+on: push
+jobs:
+  a:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ github.sha
+`)
+
+	findings, err := Check(content, nil)
+	require.NoError(t, err)
+
+	assert.Len(t, findingsByRule(findings, "expression-syntax"), 1)
+}
+
+func TestCheck_UnknownSecret(t *testing.T) {
+	content := []byte(`
+on: push
+jobs:
+  a:
+    runs-on: ubuntu-latest
+    steps:
+      - run: echo ${{ secrets.NOT_DEFINED }}
+      - run: echo ${{ secrets.GITHUB_TOKEN }}
+`)
+
+	findings, err := Check(content, []string{"OTHER_SECRET"})
+	require.NoError(t, err)
+
+	unknown := findingsByRule(findings, "unknown-secret")
+	assert.Len(t, unknown, 1)
+	assert.Contains(t, unknown[0].Message, "NOT_DEFINED")
+}
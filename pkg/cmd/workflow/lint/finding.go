@@ -0,0 +1,30 @@
+package lint
+
+// Severity classifies how serious a Finding is. Only Error causes a
+// non-zero exit from `--lint`/`lint`.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Finding is a single problem reported against a workflow file, addressable
+// by file:line:col the way compiler diagnostics are.
+type Finding struct {
+	Rule     string
+	Severity Severity
+	Message  string
+	Line     int
+	Column   int
+}
+
+// HasErrors reports whether any finding is severity Error.
+func HasErrors(findings []Finding) bool {
+	for _, f := range findings {
+		if f.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
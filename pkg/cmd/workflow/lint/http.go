@@ -0,0 +1,31 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// listSecretNames returns the names of secrets defined on the repo, used to
+// flag `${{ secrets.X }}` references to secrets that don't actually exist.
+func listSecretNames(client *api.Client, repo ghrepo.Interface) ([]string, error) {
+	type secret struct {
+		Name string `json:"name"`
+	}
+	type response struct {
+		Secrets []secret `json:"secrets"`
+	}
+
+	var result response
+	path := fmt.Sprintf("repos/%s/actions/secrets", ghrepo.FullName(repo))
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &result); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(result.Secrets))
+	for i, s := range result.Secrets {
+		names[i] = s.Name
+	}
+	return names, nil
+}
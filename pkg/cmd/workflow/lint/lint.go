@@ -0,0 +1,170 @@
+package lint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+	"github.com/cli/cli/pkg/cmd/workflow/shared"
+	"github.com/cli/cli/pkg/cmdutil"
+	"github.com/cli/cli/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type LintOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	Selector string
+	Ref      string
+	Format   string
+
+	Prompt bool
+}
+
+func NewCmdLint(f *cmdutil.Factory, runF func(*LintOptions) error) *cobra.Command {
+	opts := &LintOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:    "lint [<workflow-id> | <file name>]",
+		Short:  "Lint a workflow file",
+		Hidden: true,
+		Long: heredoc.Doc(`
+			Run an offline, actionlint-style check over a workflow file: required
+			schema keys, runs-on sanity, a needs: DAG with no cycles or dangling
+			references, balanced ${{ }} expressions, uses: refs pinned to a SHA
+			or tag, and secrets that don't exist on the repo.
+
+			Exits non-zero if any finding is an error.
+		`),
+		Example: heredoc.Doc(`
+			# Lint a workflow interactively
+			$ gh workflow lint
+
+			# Lint a specific workflow and emit SARIF for code scanning
+			$ gh workflow lint 0451 --format sarif
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if len(args) > 0 {
+				opts.Selector = args[0]
+			} else if !opts.IO.CanPrompt() {
+				return &cmdutil.FlagError{Err: errors.New("workflow argument required when not running interactively")}
+			} else {
+				opts.Prompt = true
+			}
+
+			if opts.Format != "" && opts.Format != "sarif" {
+				return &cmdutil.FlagError{Err: fmt.Errorf("unsupported --format %q", opts.Format)}
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return lintRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Ref, "ref", "r", "", "The branch or tag name which contains the version of the workflow file you'd like to lint")
+	cmd.Flags().StringVar(&opts.Format, "format", "", "Output format: {sarif}")
+
+	return cmd
+}
+
+func lintRun(opts *LintOptions) error {
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not build http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("could not determine base repo: %w", err)
+	}
+
+	states := []shared.WorkflowState{shared.Active}
+	workflow, err := shared.ResolveWorkflow(opts.IO, client, repo, opts.Prompt, opts.Selector, states)
+	if err != nil {
+		var fae shared.FilteredAllError
+		if errors.As(err, &fae) {
+			return errors.New("there are no enabled workflows")
+		}
+		return err
+	}
+
+	findings, err := Run(opts.IO, client, repo, opts.Ref, workflow)
+	if err != nil {
+		return err
+	}
+
+	return PrintFindings(opts.IO, opts.Format, workflow.Path, findings)
+}
+
+// Run fetches the workflow file and the repo's secret names, then runs
+// Check. It's exported so `workflow view --lint` can share it without
+// resolving the workflow a second time.
+func Run(io *iostreams.IOStreams, client *api.Client, repo ghrepo.Interface, ref string, workflow *shared.Workflow) ([]Finding, error) {
+	io.StartProgressIndicator()
+	content, contentErr := shared.GetWorkflowContent(client, repo, ref, workflow)
+	var secrets []string
+	var secretsErr error
+	if contentErr == nil {
+		secrets, secretsErr = listSecretNames(client, repo)
+	}
+	io.StopProgressIndicator()
+
+	if contentErr != nil {
+		return nil, fmt.Errorf("could not get workflow file content: %w", contentErr)
+	}
+	if secretsErr != nil {
+		// Secret visibility requires repo admin; degrade to skipping the
+		// unknown-secret check rather than failing the whole lint run.
+		secrets = nil
+	}
+
+	return Check([]byte(content), secrets)
+}
+
+// PrintFindings renders findings as text or, when format is "sarif", as a
+// SARIF 2.1.0 report, and returns cmdutil.SilentError if any finding is an
+// error so callers exit non-zero without printing a second message.
+func PrintFindings(io *iostreams.IOStreams, format, path string, findings []Finding) error {
+	if format == "sarif" {
+		report := ToSARIF(path, findings)
+		enc := json.NewEncoder(io.Out)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		cs := io.ColorScheme()
+		for _, f := range findings {
+			severity := f.Severity
+			color := cs.WarningIcon
+			if f.Severity == SeverityError {
+				color = cs.FailureIcon
+			}
+			fmt.Fprintf(io.Out, "%s %s:%d:%d %s (%s) %s\n", color(), path, f.Line, f.Column, severity, f.Rule, f.Message)
+		}
+		if len(findings) == 0 {
+			fmt.Fprintf(io.Out, "%s no issues found\n", cs.SuccessIcon())
+		}
+	}
+
+	if HasErrors(findings) {
+		return cmdutil.SilentError
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package shared
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+
+	"github.com/cli/cli/api"
+	"github.com/cli/cli/internal/ghrepo"
+)
+
+// GetWorkflowContent fetches and decodes a workflow file's raw YAML, at ref
+// if given or the default branch otherwise. Shared by `workflow view` and
+// `workflow lint` so the two commands can't silently drift apart.
+func GetWorkflowContent(client *api.Client, repo ghrepo.Interface, ref string, workflow *Workflow) (string, error) {
+	path := fmt.Sprintf("repos/%s/contents/%s", ghrepo.FullName(repo), workflow.Path)
+	if ref != "" {
+		path = path + fmt.Sprintf("?ref=%s", url.QueryEscape(ref))
+	}
+
+	type Result struct {
+		Content string
+	}
+
+	var result Result
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &result); err != nil {
+		return "", err
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(result.Content)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode workflow file: %w", err)
+	}
+
+	return string(decoded), nil
+}
@@ -0,0 +1,29 @@
+package view
+
+import "github.com/cli/cli/pkg/cmd/workflow/shared"
+
+var workflowViewFields = []string{"id", "name", "state", "path", "url"}
+
+type exportableWorkflow struct {
+	workflow *shared.Workflow
+	url      string
+}
+
+func (w exportableWorkflow) ExportData(fields []string) map[string]interface{} {
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "id":
+			data["id"] = w.workflow.ID
+		case "name":
+			data["name"] = w.workflow.Name
+		case "state":
+			data["state"] = w.workflow.State
+		case "path":
+			data["path"] = w.workflow.Path
+		case "url":
+			data["url"] = w.url
+		}
+	}
+	return data
+}
@@ -0,0 +1,38 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/cli/cli/pkg/cmd/workflow/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportableWorkflow_ExportData(t *testing.T) {
+	w := exportableWorkflow{
+		workflow: &shared.Workflow{
+			ID:    123,
+			Name:  "CI",
+			State: shared.Active,
+			Path:  ".github/workflows/ci.yml",
+		},
+		url: "https://github.com/OWNER/REPO/actions/workflows/123",
+	}
+
+	data := w.ExportData(workflowViewFields)
+
+	assert.Equal(t, map[string]interface{}{
+		"id":    int64(123),
+		"name":  "CI",
+		"state": shared.Active,
+		"path":  ".github/workflows/ci.yml",
+		"url":   "https://github.com/OWNER/REPO/actions/workflows/123",
+	}, data)
+}
+
+func TestExportableWorkflow_ExportData_OnlyRequestedFields(t *testing.T) {
+	w := exportableWorkflow{workflow: &shared.Workflow{Name: "CI"}}
+
+	data := w.ExportData([]string{"name"})
+
+	assert.Equal(t, map[string]interface{}{"name": "CI"}, data)
+}
@@ -1,9 +1,7 @@
 package view
 
 import (
-	"encoding/base64"
 	"fmt"
-	"net/url"
 
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/ghrepo"
@@ -16,29 +14,22 @@ type recentRuns struct {
 	RecentRuns []runShared.Run
 }
 
-func getWorkflowContent(client *api.Client, repo ghrepo.Interface, ref string, workflow *shared.Workflow) (string, error) {
-	path := fmt.Sprintf("repos/%s/contents/%s", ghrepo.FullName(repo), workflow.Path)
-	if ref != "" {
-		q := fmt.Sprintf("?ref=%s", url.QueryEscape(ref))
-		path = path + q
-	}
-
-	type Result struct {
-		Content string
-	}
+// getLatestRunURL looks up the single most recent run of the given workflow
+// and returns its HTML URL, so `--web --latest` can jump straight to it
+// instead of the workflow's overview page.
+func getLatestRunURL(client *api.Client, repo ghrepo.Interface, workflow *shared.Workflow) (string, error) {
+	path := fmt.Sprintf("repos/%s/actions/workflows/%d/runs?per_page=1", ghrepo.FullName(repo), workflow.ID)
 
-	var result Result
-	err := client.REST(repo.RepoHost(), "GET", path, nil, &result)
-	if err != nil {
+	var result runShared.RunsPayload
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &result); err != nil {
 		return "", err
 	}
 
-	decoded, err := base64.StdEncoding.DecodeString(result.Content)
-	if err != nil {
-		return "", fmt.Errorf("failed to decode workflow file: %w", err)
+	if len(result.WorkflowRuns) == 0 {
+		return "", fmt.Errorf("no runs found for %s", workflow.Name)
 	}
 
-	return string(decoded), nil
+	return result.WorkflowRuns[0].URL, nil
 }
 
 func getWorkflowRuns(client *api.Client, repo ghrepo.Interface, workflow *shared.Workflow) (recentRuns, error) {
@@ -11,6 +11,7 @@ import (
 	"github.com/cli/cli/api"
 	"github.com/cli/cli/internal/ghrepo"
 	runShared "github.com/cli/cli/pkg/cmd/run/shared"
+	"github.com/cli/cli/pkg/cmd/workflow/lint"
 	"github.com/cli/cli/pkg/cmd/workflow/shared"
 	"github.com/cli/cli/pkg/cmdutil"
 	"github.com/cli/cli/pkg/iostreams"
@@ -25,12 +26,16 @@ type ViewOptions struct {
 	BaseRepo      func() (ghrepo.Interface, error)
 	OpenInBrowser func(string) error
 
+	Exporter cmdutil.Exporter
+
 	Selector string
 	Ref      string
 	Web      bool
 	Prompt   bool
 	Raw      bool
 	Yaml     bool
+	Latest   bool
+	Lint     bool
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
@@ -78,6 +83,10 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open workflow in the browser")
 	cmd.Flags().BoolVarP(&opts.Yaml, "yaml", "y", false, "View the workflow yaml file")
+	cmd.Flags().BoolVar(&opts.Latest, "latest", false, "With --web, open the workflow's most recent run instead of the workflow page")
+	cmd.Flags().BoolVar(&opts.Lint, "lint", false, "Check the workflow file for common mistakes and exit non-zero on errors")
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, workflowViewFields)
 	//TODO: ref only applies when yaml flag is specified should it error out or alert user when ref is specified but yaml is not?
 	cmd.Flags().StringVarP(&opts.Ref, "ref", "r", "", "The branch or tag name which contains the version of the workflow file you'd like to view")
 
@@ -107,10 +116,23 @@ func runView(opts *ViewOptions) error {
 		return err
 	}
 
+	if opts.Exporter != nil {
+		baseName := filepath.Base(workflow.Path)
+		url := fmt.Sprintf("https://%s/%s/actions/workflows/%s", repo.RepoHost(), ghrepo.FullName(repo), baseName)
+		return opts.Exporter.Write(opts.IO, exportableWorkflow{workflow: workflow, url: url})
+	}
+
 	if opts.Web {
 		var url string
 		hostname := repo.RepoHost()
-		if opts.Yaml {
+		if opts.Latest {
+			opts.IO.StartProgressIndicator()
+			url, err = getLatestRunURL(client, repo, workflow)
+			opts.IO.StopProgressIndicator()
+			if err != nil {
+				return fmt.Errorf("could not find the latest run: %w", err)
+			}
+		} else if opts.Yaml {
 			ref := opts.Ref
 			if ref == "" {
 				opts.IO.StartProgressIndicator()
@@ -131,6 +153,14 @@ func runView(opts *ViewOptions) error {
 		return opts.OpenInBrowser(url)
 	}
 
+	if opts.Lint {
+		findings, err := lint.Run(opts.IO, client, repo, opts.Ref, workflow)
+		if err != nil {
+			return err
+		}
+		return lint.PrintFindings(opts.IO, "", workflow.Path, findings)
+	}
+
 	if opts.Yaml {
 		err = viewWorkflowContent(opts, client, workflow)
 	} else {
@@ -150,7 +180,7 @@ func viewWorkflowContent(opts *ViewOptions, client *api.Client, workflow *shared
 	}
 
 	opts.IO.StartProgressIndicator()
-	yaml, err := getWorkflowContent(client, repo, opts.Ref, workflow)
+	yaml, err := shared.GetWorkflowContent(client, repo, opts.Ref, workflow)
 	opts.IO.StopProgressIndicator()
 	if err != nil {
 		return fmt.Errorf("could not get workflow file content: %w", err)